@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func tableAwsVpcNetworkInsightsAnalysis(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_network_insights_analysis",
+		Description: "AWS VPC Network Insights Analysis",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("network_insights_analysis_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidNetworkInsightsAnalysisId.NotFound", "InvalidNetworkInsightsAnalysisId.Malformed"}),
+			Hydrate:           getVpcNetworkInsightsAnalysis,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcNetworkInsightsAnalyses,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "network_insights_path_id", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+				{Name: "path_found", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "network_insights_analysis_id",
+				Description: "The ID of the network insights analysis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_analysis_arn",
+				Description: "The Amazon Resource Name (ARN) of the network insights analysis.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_path_id",
+				Description: "The ID of the path on which the analysis was run.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the analysis, one of running, succeeded, or failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message, if the status is failed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "path_found",
+				Description: "Indicates whether the destination is reachable from the source.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("NetworkPathFound"),
+			},
+			{
+				Name:        "start_date",
+				Description: "The date the analysis was started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "filter_in_arns",
+				Description: "The Amazon Resource Names (ARN) of the resources that the path must traverse.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "explanations",
+				Description: "The explanations describing why the path is not reachable.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "forward_path_components",
+				Description: "The components in the path from source to destination.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "return_path_components",
+				Description: "The components in the path from destination to source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "alternate_path_hints",
+				Description: "Potential intermediate components that could be used in place of the ones displayed, had other resources been available.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "additional_accounts",
+				Description: "The IDs of the additional accounts that contributed to the analysis.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "suggested_accounts",
+				Description: "The IDs of the accounts that you need to analyze from a different account to complete the analysis.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags that are attached to the network insights analysis.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getVpcNetworkInsightsAnalysisTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getVpcNetworkInsightsAnalysisTurbotTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkInsightsAnalysisArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcNetworkInsightsAnalyses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listVpcNetworkInsightsAnalyses", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ec2.DescribeNetworkInsightsAnalysesInput{
+		MaxResults: aws.Int64(255),
+	}
+
+	// NetworkInsightsPathId is a first-class request parameter rather than a filter
+	if pathID := d.KeyColumnQualString("network_insights_path_id"); pathID != "" {
+		input.NetworkInsightsPathId = aws.String(pathID)
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "status", FilterName: "status", ColumnType: "string"},
+		{ColumnName: "path_found", FilterName: "path-found", ColumnType: "boolean"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 5 {
+				input.MaxResults = aws.Int64(5)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeNetworkInsightsAnalysesPages(
+		input,
+		func(page *ec2.DescribeNetworkInsightsAnalysesOutput, isLast bool) bool {
+			for _, analysis := range page.NetworkInsightsAnalyses {
+				d.StreamListItem(ctx, analysis)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcNetworkInsightsAnalysis(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getVpcNetworkInsightsAnalysis")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	analysisID := d.KeyColumnQuals["network_insights_analysis_id"].GetStringValue()
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeNetworkInsightsAnalysesInput{
+		NetworkInsightsAnalysisIds: []*string{aws.String(analysisID)},
+	}
+
+	// Get call
+	op, err := svc.DescribeNetworkInsightsAnalyses(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcNetworkInsightsAnalysis__", "ERROR", err)
+		return nil, err
+	}
+
+	if op.NetworkInsightsAnalyses != nil && len(op.NetworkInsightsAnalyses) > 0 {
+		return op.NetworkInsightsAnalyses[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getVpcNetworkInsightsAnalysisTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	analysis := d.HydrateItem.(*ec2.NetworkInsightsAnalysis)
+	return ec2TagsToMap(analysis.Tags)
+}
+
+func getVpcNetworkInsightsAnalysisTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	analysis := d.HydrateItem.(*ec2.NetworkInsightsAnalysis)
+	analysisData := d.HydrateResults
+	var title string
+	if analysis.Tags != nil {
+		for _, i := range analysis.Tags {
+			if *i.Key == "Name" {
+				title = *i.Value
+			}
+		}
+	}
+
+	if title == "" {
+		if analysisData["getVpcNetworkInsightsAnalysis"] != nil {
+			title = *analysisData["getVpcNetworkInsightsAnalysis"].(*ec2.NetworkInsightsAnalysis).NetworkInsightsAnalysisId
+		} else {
+			title = *analysisData["listVpcNetworkInsightsAnalyses"].(*ec2.NetworkInsightsAnalysis).NetworkInsightsAnalysisId
+		}
+	}
+	return title, nil
+}
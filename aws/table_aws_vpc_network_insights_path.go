@@ -0,0 +1,234 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+func tableAwsVpcNetworkInsightsPath(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_network_insights_path",
+		Description: "AWS VPC Network Insights Path",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("network_insights_path_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidNetworkInsightsPathId.NotFound", "InvalidNetworkInsightsPathId.Malformed"}),
+			Hydrate:           getVpcNetworkInsightsPath,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcNetworkInsightsPaths,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "source", Require: plugin.Optional},
+				{Name: "destination", Require: plugin.Optional},
+				{Name: "protocol", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "network_insights_path_id",
+				Description: "The ID of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "network_insights_path_arn",
+				Description: "The Amazon Resource Name (ARN) of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The AWS resource that is the source of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_ip",
+				Description: "The IP address of the source resource.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "destination",
+				Description: "The AWS resource that is the destination of the path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "destination_ip",
+				Description: "The IP address of the destination resource.",
+				Type:        proto.ColumnType_IPADDR,
+			},
+			{
+				Name:        "destination_port",
+				Description: "The destination port.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "protocol",
+				Description: "The protocol, either tcp or udp.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the path was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "filter_at_source",
+				Description: "The filters used to scope the analysis at the source end, such as a source/destination CIDR range or port range.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "filter_at_destination",
+				Description: "The filters used to scope the analysis at the destination end, such as a source/destination CIDR range or port range.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "tags_src",
+				Description: "A list of tags that are attached to the path.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "tags",
+				Description: resourceInterfaceDescription("tags"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(getVpcNetworkInsightsPathTurbotTags),
+			},
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getVpcNetworkInsightsPathTurbotTitle),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("NetworkInsightsPathArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcNetworkInsightsPaths(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listVpcNetworkInsightsPaths", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ec2.DescribeNetworkInsightsPathsInput{
+		MaxResults: aws.Int64(255),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "source", FilterName: "source", ColumnType: "string"},
+		{ColumnName: "destination", FilterName: "destination", ColumnType: "string"},
+		{ColumnName: "protocol", FilterName: "protocol", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 5 {
+				input.MaxResults = aws.Int64(5)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeNetworkInsightsPathsPages(
+		input,
+		func(page *ec2.DescribeNetworkInsightsPathsOutput, isLast bool) bool {
+			for _, path := range page.NetworkInsightsPaths {
+				d.StreamListItem(ctx, path)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcNetworkInsightsPath(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getVpcNetworkInsightsPath")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	pathID := d.KeyColumnQuals["network_insights_path_id"].GetStringValue()
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeNetworkInsightsPathsInput{
+		NetworkInsightsPathIds: []*string{aws.String(pathID)},
+	}
+
+	// Get call
+	op, err := svc.DescribeNetworkInsightsPaths(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcNetworkInsightsPath__", "ERROR", err)
+		return nil, err
+	}
+
+	if op.NetworkInsightsPaths != nil && len(op.NetworkInsightsPaths) > 0 {
+		return op.NetworkInsightsPaths[0], nil
+	}
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getVpcNetworkInsightsPathTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	path := d.HydrateItem.(*ec2.NetworkInsightsPath)
+	return ec2TagsToMap(path.Tags)
+}
+
+func getVpcNetworkInsightsPathTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	path := d.HydrateItem.(*ec2.NetworkInsightsPath)
+	pathData := d.HydrateResults
+	var title string
+	if path.Tags != nil {
+		for _, i := range path.Tags {
+			if *i.Key == "Name" {
+				title = *i.Value
+			}
+		}
+	}
+
+	if title == "" {
+		if pathData["getVpcNetworkInsightsPath"] != nil {
+			title = *pathData["getVpcNetworkInsightsPath"].(*ec2.NetworkInsightsPath).NetworkInsightsPathId
+		} else {
+			title = *pathData["listVpcNetworkInsightsPaths"].(*ec2.NetworkInsightsPath).NetworkInsightsPathId
+		}
+	}
+	return title, nil
+}
@@ -2,6 +2,8 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -24,6 +26,7 @@ func tableAwsVpcSubnet(_ context.Context) *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "availability_zone", Require: plugin.Optional},
 				{Name: "availability_zone_id", Require: plugin.Optional},
+				{Name: "availability_zone_type", Require: plugin.Optional},
 				{Name: "available_ip_address_count", Require: plugin.Optional},
 				{Name: "cidr_block", Require: plugin.Optional},
 				{Name: "default_for_az", Require: plugin.Optional},
@@ -32,6 +35,10 @@ func tableAwsVpcSubnet(_ context.Context) *plugin.Table {
 				{Name: "state", Require: plugin.Optional},
 				{Name: "subnet_arn", Require: plugin.Optional},
 				{Name: "vpc_id", Require: plugin.Optional},
+				{Name: "tag", Require: plugin.Optional},
+				{Name: "tag_filter", Require: plugin.Optional},
+				{Name: "ipv6_cidr_block", Require: plugin.Optional},
+				{Name: "ipv6_native", Require: plugin.Optional},
 			},
 		},
 		GetMatrixItem: BuildRegionList,
@@ -86,6 +93,34 @@ func tableAwsVpcSubnet(_ context.Context) *plugin.Table {
 				Description: "The AZ ID of the subnet.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "availability_zone_type",
+				Description: "The type of Availability Zone, Local Zone, or Wavelength Zone that the subnet is located in, e.g. availability-zone, local-zone, or wavelength-zone.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetAvailabilityZoneInfo,
+				Transform:   transform.FromField("ZoneType"),
+			},
+			{
+				Name:        "availability_zone_group_name",
+				Description: "The name of the zone group that the Availability Zone belongs to, for example us-west-2-lax-1 for a Local Zone.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetAvailabilityZoneInfo,
+				Transform:   transform.FromField("GroupName"),
+			},
+			{
+				Name:        "parent_zone_name",
+				Description: "The name of the zone that handles some of the Local Zone or Wavelength Zone control plane operations, such as API calls.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetAvailabilityZoneInfo,
+				Transform:   transform.FromField("ParentZoneName"),
+			},
+			{
+				Name:        "parent_zone_id",
+				Description: "The ID of the zone that handles some of the Local Zone or Wavelength Zone control plane operations, such as API calls.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetAvailabilityZoneInfo,
+				Transform:   transform.FromField("ParentZoneId"),
+			},
 			{
 				Name:        "customer_owned_ipv4_pool",
 				Description: "The customer-owned IPv4 address pool associated with the subnet.",
@@ -116,12 +151,82 @@ func tableAwsVpcSubnet(_ context.Context) *plugin.Table {
 				Description: "A list of IPv6 CIDR blocks associated with the subnet.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "ipv6_native",
+				Description: "Indicates whether this is an IPv6-only subnet.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "ipv6_cidr_block",
+				Description: "The first IPv6 CIDR block associated with the subnet.",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.From(getVpcSubnetIpv6CidrBlock),
+			},
+			{
+				Name:        "ipv6_cidr_block_state",
+				Description: "The state of the first IPv6 CIDR block association, e.g. associated, associating, disassociated, disassociating, or failing.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getVpcSubnetIpv6CidrBlockState),
+			},
+			{
+				Name:        "ipv6_cidr_block_association_id",
+				Description: "The ID of the first IPv6 CIDR block association.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(getVpcSubnetIpv6CidrBlockAssociationId),
+			},
+			{
+				Name:        "subnet_type",
+				Description: "The inferred role of the subnet derived from its route table - public if it has a default route to an internet gateway, private if it has a default route to a NAT gateway, instance, or transit gateway, otherwise isolated.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetRouteTableInfo,
+				Transform:   transform.FromField("SubnetType"),
+			},
+			{
+				Name:        "route_table_id",
+				Description: "The ID of the route table associated with the subnet, or the VPC's main route table if no explicit association exists.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetRouteTableInfo,
+				Transform:   transform.FromField("RouteTableId"),
+			},
+			{
+				Name:        "route_table_association_id",
+				Description: "The ID of the association between the route table and the subnet, or the ID of the main route table association if no explicit association exists.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetRouteTableInfo,
+				Transform:   transform.FromField("RouteTableAssociationId"),
+			},
+			{
+				Name:        "nat_gateway_id",
+				Description: "The ID of the NAT gateway targeted by the subnet's default route, if any.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetRouteTableInfo,
+				Transform:   transform.FromField("NatGatewayId"),
+			},
+			{
+				Name:        "internet_gateway_id",
+				Description: "The ID of the internet gateway targeted by the subnet's default route, if any.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getVpcSubnetRouteTableInfo,
+				Transform:   transform.FromField("InternetGatewayId"),
+			},
 			{
 				Name:        "tags_src",
 				Description: "A list of tags that are attached to the subnet.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Tags"),
 			},
+			{
+				Name:        "tag",
+				Description: "Filter-only column. Restricts the list to subnets carrying the given tag key, e.g. 'kubernetes.io/role/elb', pushed down to the EC2 tag-key filter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("tag"),
+			},
+			{
+				Name:        "tag_filter",
+				Description: "Filter-only column. Restricts the list to subnets matching the given map of tag key/value pairs, pushed down as tag:<Key> filters.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("tag_filter"),
+			},
 
 			// Standard columns for all tables
 			{
@@ -173,6 +278,8 @@ func listVpcSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
 		{ColumnName: "subnet_arn", FilterName: "subnet-arn", ColumnType: "string"},
 		{ColumnName: "vpc_id", FilterName: "vpc-id", ColumnType: "string"},
+		{ColumnName: "ipv6_cidr_block", FilterName: "ipv6-cidr-block-association.ipv6-cidr-block", ColumnType: "cidr"},
+		{ColumnName: "ipv6_native", FilterName: "ipv6-native", ColumnType: "boolean"},
 	}
 
 	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
@@ -180,6 +287,119 @@ func listVpcSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		input.Filters = filters
 	}
 
+	// A bare tag key (e.g. WHERE tag = 'kubernetes.io/role/elb') pushes down as tag-key
+	if equalQuals := d.Quals["tag"]; equalQuals != nil {
+		for _, q := range equalQuals.Quals {
+			if q.Operator == "=" {
+				input.Filters = append(input.Filters, &ec2.Filter{
+					Name:   aws.String("tag-key"),
+					Values: []*string{aws.String(q.Value.GetStringValue())},
+				})
+			}
+		}
+	}
+
+	// A JSONB map of tag key/value pairs (e.g. WHERE tag_filter = '{"kubernetes.io/role/elb": "1"}')
+	// pushes down as one tag:<Key> filter per entry
+	if equalQuals := d.Quals["tag_filter"]; equalQuals != nil {
+		for _, q := range equalQuals.Quals {
+			if q.Operator != "=" {
+				continue
+			}
+			var tagFilter map[string]string
+			if err := json.Unmarshal([]byte(q.Value.GetJsonbValue()), &tagFilter); err != nil {
+				return nil, err
+			}
+			for key, value := range tagFilter {
+				input.Filters = append(input.Filters, &ec2.Filter{
+					Name:   aws.String("tag:" + key),
+					Values: []*string{aws.String(value)},
+				})
+			}
+		}
+	}
+
+	// DescribeSubnets has no zone-type filter of its own, and the
+	// availability_zone_type/_group_name/parent_zone_* columns need the same
+	// zone metadata for every row. Only pay for the (small, static) zone list
+	// once here - instead of per subnet in the hydrate function - when a
+	// caller actually filters or selects on it; a plain "select subnet_id,
+	// cidr_block" query should still work for a role scoped to just
+	// ec2:DescribeSubnets.
+	zoneType := d.KeyColumnQualString("availability_zone_type")
+	var zonesByName map[string]*ec2.AvailabilityZone
+	var availabilityZoneTypeFilter map[string]bool
+	if zoneType != "" || queryContextHasColumn(d, "availability_zone_type", "availability_zone_group_name", "parent_zone_name", "parent_zone_id") {
+		azOp, err := svc.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+		if err != nil {
+			return nil, err
+		}
+		zonesByName = make(map[string]*ec2.AvailabilityZone, len(azOp.AvailabilityZones))
+		for _, az := range azOp.AvailabilityZones {
+			zonesByName[aws.StringValue(az.ZoneName)] = az
+		}
+
+		if zoneType != "" {
+			availabilityZoneTypeFilter = make(map[string]bool, len(zonesByName))
+			for name, az := range zonesByName {
+				if aws.StringValue(az.ZoneType) == zoneType {
+					availabilityZoneTypeFilter[name] = true
+				}
+			}
+		}
+	}
+
+	// subnet_type/route_table_id/route_table_association_id/nat_gateway_id/
+	// internet_gateway_id are all derived from the route table governing
+	// each subnet. Resolving that per row (one DescribeRouteTables call for
+	// the explicit association, plus a second for the VPC's main table when
+	// there isn't one) turns a subnet scan into thousands of serial EC2
+	// calls, so - only when one of those columns is actually requested -
+	// fetch every route table in the region (scoped to vpc_id if the query
+	// already filters on it) once here and index it by subnet and by VPC
+	// main association instead.
+	var routeAssociationBySubnet map[string]*vpcSubnetRouteAssociation
+	var mainRouteAssociationByVpc map[string]*vpcSubnetRouteAssociation
+	if queryContextHasColumn(d, "subnet_type", "route_table_id", "route_table_association_id", "nat_gateway_id", "internet_gateway_id") {
+		routeTableInput := &ec2.DescribeRouteTablesInput{}
+		if equalQuals := d.Quals["vpc_id"]; equalQuals != nil {
+			for _, q := range equalQuals.Quals {
+				if q.Operator == "=" {
+					routeTableInput.Filters = append(routeTableInput.Filters, &ec2.Filter{
+						Name:   aws.String("vpc-id"),
+						Values: []*string{aws.String(q.Value.GetStringValue())},
+					})
+				}
+			}
+		}
+
+		routeAssociationBySubnet = map[string]*vpcSubnetRouteAssociation{}
+		mainRouteAssociationByVpc = map[string]*vpcSubnetRouteAssociation{}
+		err = svc.DescribeRouteTablesPages(
+			routeTableInput,
+			func(page *ec2.DescribeRouteTablesOutput, isLast bool) bool {
+				for _, routeTable := range page.RouteTables {
+					for _, assoc := range routeTable.Associations {
+						association := &vpcSubnetRouteAssociation{
+							RouteTable:    routeTable,
+							AssociationId: aws.StringValue(assoc.RouteTableAssociationId),
+						}
+						if assoc.SubnetId != nil {
+							routeAssociationBySubnet[aws.StringValue(assoc.SubnetId)] = association
+						}
+						if aws.BoolValue(assoc.Main) {
+							mainRouteAssociationByVpc[aws.StringValue(routeTable.VpcId)] = association
+						}
+					}
+				}
+				return !isLast
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Reduce the basic request limit down if the user has only requested a small number of rows
 	limit := d.QueryContext.Limit
 	if d.QueryContext.Limit != nil {
@@ -197,7 +417,18 @@ func listVpcSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		input,
 		func(page *ec2.DescribeSubnetsOutput, isLast bool) bool {
 			for _, subnet := range page.Subnets {
-				d.StreamListItem(ctx, subnet)
+				if availabilityZoneTypeFilter != nil && !availabilityZoneTypeFilter[*subnet.AvailabilityZone] {
+					continue
+				}
+				routeAssociation := routeAssociationBySubnet[aws.StringValue(subnet.SubnetId)]
+				if routeAssociation == nil {
+					routeAssociation = mainRouteAssociationByVpc[aws.StringValue(subnet.VpcId)]
+				}
+				d.StreamListItem(ctx, &vpcSubnetListItem{
+					Subnet:           subnet,
+					availabilityZone: zonesByName[aws.StringValue(subnet.AvailabilityZone)],
+					routeAssociation: routeAssociation,
+				})
 
 				// Context may get cancelled due to manual cancellation or if the limit has been reached
 				if d.QueryStatus.RowsRemaining(ctx) == 0 {
@@ -211,6 +442,49 @@ func listVpcSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return nil, err
 }
 
+// vpcSubnetListItem is the row type streamed by listVpcSubnets. It carries
+// the per-region lookups (availability zone metadata, route table
+// association) resolved once for the whole List call, so the hydrate
+// functions below can serve every row from these maps instead of issuing an
+// EC2 API call per subnet.
+type vpcSubnetListItem struct {
+	*ec2.Subnet
+	availabilityZone *ec2.AvailabilityZone
+	routeAssociation *vpcSubnetRouteAssociation
+}
+
+// vpcSubnetRouteAssociation pairs a route table with the specific
+// association (explicit subnet association, or the VPC's main table) that
+// makes it the one governing a given subnet.
+type vpcSubnetRouteAssociation struct {
+	RouteTable    *ec2.RouteTable
+	AssociationId string
+}
+
+// subnetFromHydrateItem unwraps a hydrate base item back to the underlying
+// *ec2.Subnet, whether it came from the Get call (a bare *ec2.Subnet) or the
+// List call (a *vpcSubnetListItem wrapping one).
+func subnetFromHydrateItem(item interface{}) *ec2.Subnet {
+	if row, ok := item.(*vpcSubnetListItem); ok {
+		return row.Subnet
+	}
+	return item.(*ec2.Subnet)
+}
+
+// queryContextHasColumn reports whether the query requested any of the
+// given columns, so a List hydrate can skip an extra API call - and the IAM
+// permission it requires - when none of its derived columns are needed.
+func queryContextHasColumn(d *plugin.QueryData, columns ...string) bool {
+	for _, requested := range d.QueryContext.Columns {
+		for _, column := range columns {
+			if requested == column {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 //// HYDRATE FUNCTIONS
 
 func getVpcSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -243,15 +517,200 @@ func getVpcSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	return nil, nil
 }
 
+func getVpcSubnetAvailabilityZoneInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// listVpcSubnets already resolves this against the region's zone list
+	// fetched once for the whole List call.
+	if row, ok := h.Item.(*vpcSubnetListItem); ok {
+		if row.availabilityZone == nil {
+			return nil, nil
+		}
+		return row.availabilityZone, nil
+	}
+
+	// Get path - a single row, so a direct lookup is fine here.
+	subnet := h.Item.(*ec2.Subnet)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("zone-name"),
+				Values: []*string{subnet.AvailabilityZone},
+			},
+		},
+	}
+
+	op, err := svc.DescribeAvailabilityZones(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcSubnetAvailabilityZoneInfo", "ERROR", err)
+		return nil, err
+	}
+
+	if op.AvailabilityZones != nil && len(op.AvailabilityZones) > 0 {
+		return op.AvailabilityZones[0], nil
+	}
+	return nil, nil
+}
+
+// vpcSubnetRouteInfo is the derived role and routing details for a subnet,
+// built from the route table it is associated with.
+type vpcSubnetRouteInfo struct {
+	SubnetType              string
+	RouteTableId            string
+	RouteTableAssociationId string
+	NatGatewayId            string
+	InternetGatewayId       string
+}
+
+func getVpcSubnetRouteTableInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// listVpcSubnets already resolves this against the region's route tables
+	// fetched once for the whole List call.
+	if row, ok := h.Item.(*vpcSubnetListItem); ok {
+		if row.routeAssociation == nil {
+			return vpcSubnetRouteInfoFromRouteTable(nil, ""), nil
+		}
+		return vpcSubnetRouteInfoFromRouteTable(row.routeAssociation.RouteTable, row.routeAssociation.AssociationId), nil
+	}
+
+	// Get path - a single row, so a direct lookup is fine here.
+	subnet := h.Item.(*ec2.Subnet)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := svc.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("association.subnet-id"),
+				Values: []*string{subnet.SubnetId},
+			},
+		},
+	})
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcSubnetRouteTableInfo", "ERROR", err)
+		return nil, err
+	}
+
+	var routeTable *ec2.RouteTable
+	var associationID string
+
+	if len(op.RouteTables) > 0 {
+		routeTable = op.RouteTables[0]
+		for _, assoc := range routeTable.Associations {
+			if aws.StringValue(assoc.SubnetId) == aws.StringValue(subnet.SubnetId) {
+				associationID = aws.StringValue(assoc.RouteTableAssociationId)
+				break
+			}
+		}
+	} else {
+		// No explicit association - fall back to the VPC's main route table
+		mainOp, err := svc.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+			Filters: []*ec2.Filter{
+				{Name: aws.String("vpc-id"), Values: []*string{subnet.VpcId}},
+				{Name: aws.String("association.main"), Values: []*string{aws.String("true")}},
+			},
+		})
+		if err != nil {
+			plugin.Logger(ctx).Debug("getVpcSubnetRouteTableInfo", "ERROR", err)
+			return nil, err
+		}
+		if len(mainOp.RouteTables) > 0 {
+			routeTable = mainOp.RouteTables[0]
+			for _, assoc := range routeTable.Associations {
+				if aws.BoolValue(assoc.Main) {
+					associationID = aws.StringValue(assoc.RouteTableAssociationId)
+					break
+				}
+			}
+		}
+	}
+
+	return vpcSubnetRouteInfoFromRouteTable(routeTable, associationID), nil
+}
+
+// vpcSubnetRouteInfoFromRouteTable derives a subnet's role and routing
+// details from the route table that governs it, shared by both the batched
+// List path and the single-row Get path.
+func vpcSubnetRouteInfoFromRouteTable(routeTable *ec2.RouteTable, associationID string) *vpcSubnetRouteInfo {
+	info := &vpcSubnetRouteInfo{SubnetType: "isolated"}
+	if routeTable == nil {
+		return info
+	}
+
+	info.RouteTableId = aws.StringValue(routeTable.RouteTableId)
+	info.RouteTableAssociationId = associationID
+
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != "0.0.0.0/0" {
+			continue
+		}
+		// A blackholed route's target (e.g. a deleted NAT/internet gateway)
+		// no longer routes traffic anywhere, so it doesn't make the subnet
+		// public or private.
+		if aws.StringValue(route.State) == "blackhole" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-"):
+			info.InternetGatewayId = aws.StringValue(route.GatewayId)
+			info.SubnetType = "public"
+		case route.NatGatewayId != nil:
+			info.NatGatewayId = aws.StringValue(route.NatGatewayId)
+			if info.SubnetType != "public" {
+				info.SubnetType = "private"
+			}
+		case route.InstanceId != nil || route.TransitGatewayId != nil:
+			if info.SubnetType != "public" {
+				info.SubnetType = "private"
+			}
+		}
+	}
+
+	return info
+}
+
 //// TRANSFORM FUNCTIONS
 
+func getVpcSubnetIpv6CidrBlock(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subnet := subnetFromHydrateItem(d.HydrateItem)
+	if len(subnet.Ipv6CidrBlockAssociationSet) > 0 {
+		return subnet.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock, nil
+	}
+	return nil, nil
+}
+
+func getVpcSubnetIpv6CidrBlockState(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subnet := subnetFromHydrateItem(d.HydrateItem)
+	if len(subnet.Ipv6CidrBlockAssociationSet) > 0 && subnet.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlockState != nil {
+		return subnet.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlockState.State, nil
+	}
+	return nil, nil
+}
+
+func getVpcSubnetIpv6CidrBlockAssociationId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subnet := subnetFromHydrateItem(d.HydrateItem)
+	if len(subnet.Ipv6CidrBlockAssociationSet) > 0 {
+		return subnet.Ipv6CidrBlockAssociationSet[0].AssociationId, nil
+	}
+	return nil, nil
+}
+
 func getVpcSubnetTurbotTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	subnet := d.HydrateItem.(*ec2.Subnet)
+	subnet := subnetFromHydrateItem(d.HydrateItem)
 	return ec2TagsToMap(subnet.Tags)
 }
 
 func getSubnetTurbotTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	subnet := d.HydrateItem.(*ec2.Subnet)
+	subnet := subnetFromHydrateItem(d.HydrateItem)
 	subnetData := d.HydrateResults
 	var title string
 	if subnet.Tags != nil {
@@ -264,9 +723,9 @@ func getSubnetTurbotTitle(_ context.Context, d *transform.TransformData) (interf
 
 	if title == "" {
 		if subnetData["getVpcSubnet"] != nil {
-			title = *subnetData["getVpcSubnet"].(*ec2.Subnet).SubnetId
+			title = *subnetFromHydrateItem(subnetData["getVpcSubnet"]).SubnetId
 		} else {
-			title = *subnetData["listVpcSubnets"].(*ec2.Subnet).SubnetId
+			title = *subnetFromHydrateItem(subnetData["listVpcSubnets"]).SubnetId
 		}
 	}
 	return title, nil
@@ -0,0 +1,310 @@
+package aws
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/turbot/steampipe-plugin-sdk/v3/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v3/plugin/transform"
+)
+
+// subnetReservedIPCount is the number of IPv4 addresses AWS reserves in every subnet:
+// the network address, the VPC router, the two reserved for DNS, and the broadcast address.
+const subnetReservedIPCount = 5
+
+func tableAwsVpcSubnetIPUsage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "aws_vpc_subnet_ip_usage",
+		Description: "AWS VPC Subnet IP Usage",
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("subnet_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"InvalidSubnetID.Malformed", "InvalidSubnetID.NotFound"}),
+			Hydrate:           getVpcSubnetIPUsageSubnet,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVpcSubnetIPUsageSubnets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "availability_zone", Require: plugin.Optional},
+				{Name: "state", Require: plugin.Optional},
+				{Name: "vpc_id", Require: plugin.Optional},
+			},
+		},
+		GetMatrixItem: BuildRegionList,
+		Columns: awsRegionalColumns([]*plugin.Column{
+			{
+				Name:        "subnet_id",
+				Description: "Contains the unique ID to specify a subnet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "ID of the VPC, the subnet is in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cidr_block",
+				Description: "Contains the IPv4 CIDR block assigned to the subnet.",
+				Type:        proto.ColumnType_CIDR,
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the subnet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "Current state of the subnet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "total_ip_count",
+				Description: "The total number of IPv4 addresses in the subnet's CIDR block.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CidrBlock").Transform(getVpcSubnetTotalIPCount),
+			},
+			{
+				Name:        "available_ip_address_count",
+				Description: "The number of unused private IPv4 addresses in the subnet. The IPv4 addresses for any stopped instances are considered unavailable.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "used_ip_count",
+				Description: "The number of private IPv4 addresses, both primary and secondary, assigned to network interfaces in the subnet.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetIPUsageDetails,
+				Transform:   transform.FromField("UsedIPCount"),
+			},
+			{
+				Name:        "reserved_ip_count",
+				Description: "The number of IPv4 addresses AWS reserves in every subnet for the network address, the VPC router, DNS, and the broadcast address.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getVpcSubnetIPUsageDetails,
+				Transform:   transform.FromField("ReservedIPCount"),
+			},
+			{
+				Name:        "network_interfaces_summary",
+				Description: "A count of the network interfaces in the subnet, grouped by interface type, such as interface, nat_gateway, or vpc_endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getVpcSubnetIPUsageDetails,
+				Transform:   transform.FromField("NetworkInterfacesSummary"),
+			},
+			{
+				Name:        "utilization_percent",
+				Description: "The percentage of the subnet's total IPv4 addresses that are used or reserved.",
+				Type:        proto.ColumnType_DOUBLE,
+				Hydrate:     getVpcSubnetIPUsageDetails,
+				Transform:   transform.FromField("UtilizationPercent"),
+			},
+
+			// Standard columns for all tables
+			{
+				Name:        "title",
+				Description: resourceInterfaceDescription("title"),
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubnetId"),
+			},
+			{
+				Name:        "akas",
+				Description: resourceInterfaceDescription("akas"),
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SubnetArn").Transform(arnToAkas),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVpcSubnetIPUsageSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	plugin.Logger(ctx).Trace("listVpcSubnetIPUsageSubnets", "AWS_REGION", region)
+
+	// Create session
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ec2.DescribeSubnetsInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	filterKeyMap := []VpcFilterKeyMap{
+		{ColumnName: "availability_zone", FilterName: "availability-zone", ColumnType: "string"},
+		{ColumnName: "state", FilterName: "state", ColumnType: "string"},
+		{ColumnName: "vpc_id", FilterName: "vpc-id", ColumnType: "string"},
+	}
+
+	filters := buildVpcResourcesFilterParameter(filterKeyMap, d.Quals)
+	if len(filters) > 0 {
+		input.Filters = filters
+	}
+
+	// Reduce the basic request limit down if the user has only requested a small number of rows
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *input.MaxResults {
+			if *limit < 5 {
+				input.MaxResults = aws.Int64(5)
+			} else {
+				input.MaxResults = limit
+			}
+		}
+	}
+
+	// List call
+	err = svc.DescribeSubnetsPages(
+		input,
+		func(page *ec2.DescribeSubnetsOutput, isLast bool) bool {
+			for _, subnet := range page.Subnets {
+				d.StreamListItem(ctx, subnet)
+
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return false
+				}
+			}
+			return !isLast
+		},
+	)
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVpcSubnetIPUsageSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getVpcSubnetIPUsageSubnet")
+
+	region := d.KeyColumnQualString(matrixKeyRegion)
+	subnetID := d.KeyColumnQuals["subnet_id"].GetStringValue()
+
+	// get service
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the params
+	params := &ec2.DescribeSubnetsInput{
+		SubnetIds: []*string{aws.String(subnetID)},
+	}
+
+	// Get call
+	op, err := svc.DescribeSubnets(params)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcSubnetIPUsageSubnet__", "ERROR", err)
+		return nil, err
+	}
+
+	if op.Subnets != nil && len(op.Subnets) > 0 {
+		return op.Subnets[0], nil
+	}
+	return nil, nil
+}
+
+// vpcSubnetIPUsage is the derived IP inventory for a subnet, built from the
+// network interfaces attached to it.
+type vpcSubnetIPUsage struct {
+	UsedIPCount              int64
+	ReservedIPCount          int64
+	NetworkInterfacesSummary map[string]int64
+	UtilizationPercent       float64
+}
+
+func getVpcSubnetIPUsageDetails(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subnet := h.Item.(*ec2.Subnet)
+	region := d.KeyColumnQualString(matrixKeyRegion)
+
+	svc, err := Ec2Service(ctx, d, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("subnet-id"),
+				Values: []*string{subnet.SubnetId},
+			},
+		},
+	}
+
+	summary := map[string]int64{}
+	var usedIPCount int64
+	err = svc.DescribeNetworkInterfacesPages(
+		input,
+		func(page *ec2.DescribeNetworkInterfacesOutput, isLast bool) bool {
+			for _, ni := range page.NetworkInterfaces {
+				summary[normalizeNetworkInterfaceType(ni)]++
+				usedIPCount += int64(len(ni.PrivateIpAddresses))
+			}
+			return !isLast
+		},
+	)
+	if err != nil {
+		plugin.Logger(ctx).Debug("getVpcSubnetIPUsageDetails", "ERROR", err)
+		return nil, err
+	}
+
+	usage := &vpcSubnetIPUsage{
+		UsedIPCount:              usedIPCount,
+		ReservedIPCount:          subnetReservedIPCount,
+		NetworkInterfacesSummary: summary,
+	}
+
+	if total := getVpcSubnetTotalIPCountFromCidr(subnet.CidrBlock); total > 0 {
+		usage.UtilizationPercent = (float64(usedIPCount+subnetReservedIPCount) / float64(total)) * 100
+	}
+
+	return usage, nil
+}
+
+// normalizeNetworkInterfaceType maps a network interface to the snake_case
+// bucket it should be grouped under in network_interfaces_summary. The EC2
+// NetworkInterfaceType enum is snake_case throughout (vpc_endpoint,
+// transit_gateway, network_load_balancer, load_balancer, ...) except for
+// natGateway, and has no distinct value for Lambda ENIs, which are
+// surfaced as a plain "interface" with a recognizable description.
+func normalizeNetworkInterfaceType(ni *ec2.NetworkInterface) string {
+	interfaceType := "interface"
+	if ni.InterfaceType != nil {
+		interfaceType = *ni.InterfaceType
+	}
+
+	if interfaceType == "natGateway" {
+		return "nat_gateway"
+	}
+
+	if interfaceType == "interface" && ni.Description != nil && strings.HasPrefix(*ni.Description, "AWS Lambda VPC ENI") {
+		return "lambda"
+	}
+
+	return interfaceType
+}
+
+//// TRANSFORM FUNCTIONS
+
+func getVpcSubnetTotalIPCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	cidrBlock, ok := d.Value.(*string)
+	if !ok || cidrBlock == nil {
+		return nil, nil
+	}
+	return getVpcSubnetTotalIPCountFromCidr(cidrBlock), nil
+}
+
+func getVpcSubnetTotalIPCountFromCidr(cidrBlock *string) int64 {
+	if cidrBlock == nil {
+		return 0
+	}
+	_, ipNet, err := net.ParseCIDR(*cidrBlock)
+	if err != nil {
+		return 0
+	}
+	ones, bits := ipNet.Mask.Size()
+	return int64(1) << uint(bits-ones)
+}